@@ -0,0 +1,279 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cacheKeyPrecision is how many decimal places of lat/lon are kept when
+// building a cache key. Coordinates that round to the same value share a
+// cache entry, since OpenWeatherMap's data doesn't vary at a finer
+// resolution than this anyway.
+const cacheKeyPrecision = 2
+
+// staleMaxAgeMultiplier bounds how long a stale entry may still be served
+// (and kept on disk) after its TTL has passed, as a multiple of the TTL.
+const staleMaxAgeMultiplier = 24
+
+// CacheStatus describes how a weather response was served relative to
+// the on-disk cache, and is surfaced to clients via the X-Cache header.
+type CacheStatus string
+
+const (
+	CacheMiss  CacheStatus = "MISS"
+	CacheHit   CacheStatus = "HIT"
+	CacheStale CacheStatus = "STALE"
+)
+
+type cacheEntry struct {
+	Weather   *NormalizedWeather `json:"weather"`
+	FetchedAt time.Time          `json:"fetched_at"`
+}
+
+// WeatherCache is an on-disk, TTL-based cache of normalized weather
+// responses, keyed by rounded coordinates, provider, and units. It keeps
+// entries past their TTL (up to staleMaxAgeMultiplier*ttl) so callers can
+// fall back to a stale response when the upstream provider is failing.
+type WeatherCache struct {
+	dir string
+	ttl time.Duration
+
+	mu     sync.Mutex
+	hits   int64
+	misses int64
+	stale  int64
+}
+
+func NewWeatherCache(dir string, ttl time.Duration) (*WeatherCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating weather cache directory: %w", err)
+	}
+	return &WeatherCache{dir: dir, ttl: ttl}, nil
+}
+
+// CacheKey builds the cache key for a coordinate/provider/units
+// combination. Coordinates are formatted to cacheKeyPrecision decimal
+// places so nearby requests share an entry.
+func CacheKey(lat, lon float64, providerName, units string) string {
+	return fmt.Sprintf("%s:%s:%.*f,%.*f", providerName, units, cacheKeyPrecision, lat, cacheKeyPrecision, lon)
+}
+
+func (c *WeatherCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, fmt.Sprintf("%x.json", sum))
+}
+
+// Get returns the cached entry for key, if any file exists for it and it
+// is not yet too old to serve even as stale, along with whether it is
+// still within its TTL (fresh) or past it (stale). An entry older than
+// staleMaxAgeMultiplier*ttl is reported as not found, the same bound
+// evictExpired uses to delete it from disk.
+func (c *WeatherCache) Get(key string) (weather *NormalizedWeather, fresh bool, ok bool) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, false
+	}
+
+	age := time.Since(entry.FetchedAt)
+	if age > c.ttl*staleMaxAgeMultiplier {
+		return nil, false, false
+	}
+	return entry.Weather, age <= c.ttl, true
+}
+
+func (c *WeatherCache) Set(key string, weather *NormalizedWeather) error {
+	entry := cacheEntry{Weather: weather, FetchedAt: time.Now()}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path(key), data, 0600)
+}
+
+func (c *WeatherCache) recordHit() {
+	c.mu.Lock()
+	c.hits++
+	c.mu.Unlock()
+}
+
+func (c *WeatherCache) recordMiss() {
+	c.mu.Lock()
+	c.misses++
+	c.mu.Unlock()
+}
+
+func (c *WeatherCache) recordStale() {
+	c.mu.Lock()
+	c.stale++
+	c.mu.Unlock()
+}
+
+// Stats is a point-in-time snapshot of cache activity and size, returned
+// by the /cache/stats admin endpoint.
+type Stats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Stale   int64 `json:"stale"`
+	Entries int   `json:"entries"`
+}
+
+func (c *WeatherCache) Stats() Stats {
+	entries, _ := ioutil.ReadDir(c.dir)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Stale:   c.stale,
+		Entries: len(entries),
+	}
+}
+
+// Purge deletes every entry in the cache and resets its counters.
+func (c *WeatherCache) Purge() error {
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.Remove(filepath.Join(c.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.hits, c.misses, c.stale = 0, 0, 0
+	c.mu.Unlock()
+	return nil
+}
+
+// RunEvictionLoop periodically removes entries that are too old to ever
+// be served, even as stale. It blocks until stop is closed, so callers
+// should run it in its own goroutine.
+func (c *WeatherCache) RunEvictionLoop(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.evictExpired()
+		}
+	}
+}
+
+func (c *WeatherCache) evictExpired() {
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	maxAge := c.ttl * staleMaxAgeMultiplier
+	for _, fileInfo := range entries {
+		path := filepath.Join(c.dir, fileInfo.Name())
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		var entry cacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			os.Remove(path)
+			continue
+		}
+
+		if time.Since(entry.FetchedAt) > maxAge {
+			os.Remove(path)
+		}
+	}
+}
+
+// CachingProvider wraps a WeatherProvider with an on-disk cache. Fresh
+// cache hits are served without contacting the upstream provider at all.
+// On a cache miss it fetches from the upstream provider and populates the
+// cache; if the upstream fetch fails and a stale entry exists, that stale
+// entry is served instead of the error.
+type CachingProvider struct {
+	Upstream WeatherProvider
+	Cache    *WeatherCache
+}
+
+func NewCachingProvider(upstream WeatherProvider, cache *WeatherCache) *CachingProvider {
+	return &CachingProvider{Upstream: upstream, Cache: cache}
+}
+
+func (c *CachingProvider) Name() string {
+	return c.Upstream.Name()
+}
+
+// FetchCurrent satisfies WeatherProvider by deferring to FetchCurrentCached
+// and discarding the cache status.
+func (c *CachingProvider) FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error) {
+	weather, _, err := c.FetchCurrentCached(ctx, lat, lon, opts)
+	return weather, err
+}
+
+// FetchCurrentCached behaves like FetchCurrent but also reports whether
+// the response was served from a fresh cache entry, fetched from the
+// upstream provider, or served stale after an upstream error.
+func (c *CachingProvider) FetchCurrentCached(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, CacheStatus, error) {
+	key := CacheKey(lat, lon, c.Upstream.Name(), opts.units())
+
+	if weather, fresh, ok := c.Cache.Get(key); ok && fresh {
+		c.Cache.recordHit()
+		return weather, CacheHit, nil
+	}
+
+	weather, err := c.Upstream.FetchCurrent(ctx, lat, lon, opts)
+	if err != nil {
+		if stale, _, ok := c.Cache.Get(key); ok {
+			c.Cache.recordStale()
+			return stale, CacheStale, nil
+		}
+		return nil, "", err
+	}
+
+	c.Cache.recordMiss()
+	c.Cache.Set(key, weather)
+	return weather, CacheMiss, nil
+}
+
+// requireAdminToken protects the cache admin endpoints with a bearer
+// token. If token is empty, the admin endpoints are disabled entirely
+// rather than left open.
+func requireAdminToken(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+
+			got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// sentinelError is a plain string that satisfies error, used for the
+// handful of well-known upstream/client failure modes the handlers need
+// to distinguish. Providers wrap one of these with fmt.Errorf("%w: ...")
+// so errors.Is still matches through the wrapping.
+type sentinelError string
+
+func (e sentinelError) Error() string { return string(e) }
+
+const (
+	// ErrUpstreamUnavailable means the upstream weather/geocoding
+	// provider could not be reached, timed out, or returned an
+	// unexpected 5xx/non-200 status.
+	ErrUpstreamUnavailable = sentinelError("upstream provider unavailable")
+	// ErrUpstreamRateLimited means the upstream provider returned 429.
+	ErrUpstreamRateLimited = sentinelError("upstream provider rate limited this request")
+	// ErrInvalidCoordinates means the request's coordinates, city, or
+	// zip code could not be parsed or resolved to a location.
+	ErrInvalidCoordinates = sentinelError("invalid coordinates")
+	// ErrUpstreamAuth means the upstream provider rejected our API key.
+	ErrUpstreamAuth = sentinelError("upstream provider rejected the API key")
+)
+
+// defaultRetryAfterSeconds is sent with 429 responses, since our
+// upstreams don't reliably return their own Retry-After value to relay.
+const defaultRetryAfterSeconds = "30"
+
+type errorResponse struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// statusForError maps one of the sentinel errors above (however deeply
+// wrapped) to an HTTP status code and a stable machine-readable code
+// string. Anything that doesn't match one of them is treated as an
+// unexpected internal error.
+func statusForError(err error) (status int, code string) {
+	switch {
+	case errors.Is(err, ErrInvalidCoordinates):
+		return http.StatusBadRequest, "invalid_coordinates"
+	case errors.Is(err, ErrUpstreamRateLimited):
+		return http.StatusTooManyRequests, "upstream_rate_limited"
+	case errors.Is(err, ErrUpstreamAuth):
+		return http.StatusBadGateway, "upstream_auth_error"
+	case errors.Is(err, ErrUpstreamUnavailable):
+		return http.StatusBadGateway, "upstream_unavailable"
+	default:
+		return http.StatusInternalServerError, "internal_error"
+	}
+}
+
+// writeError maps err to a status code via statusForError and writes it
+// as a structured JSON body, including the chi request ID for log
+// correlation.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := statusForError(err)
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", defaultRetryAfterSeconds)
+	}
+
+	log.Println(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorResponse{
+		Code:      code,
+		Message:   err.Error(),
+		RequestID: middleware.GetReqID(r.Context()),
+	})
+}
+
+// apiHandler is an http.Handler whose handler function returns an error
+// instead of writing a failure response directly; ServeHTTP maps any
+// returned error to a response via writeError.
+type apiHandler func(w http.ResponseWriter, r *http.Request) error
+
+func (h apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h(w, r); err != nil {
+		writeError(w, r, err)
+	}
+}
+
+// classifyUpstreamResponse turns a non-200 response from an upstream
+// HTTP API into the appropriate sentinel error.
+func classifyUpstreamResponse(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: status %d", ErrUpstreamAuth, resp.StatusCode)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: status %d", ErrUpstreamRateLimited, resp.StatusCode)
+	default:
+		return fmt.Errorf("%w: status %d", ErrUpstreamUnavailable, resp.StatusCode)
+	}
+}
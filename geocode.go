@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Location is a resolved geographic coordinate, along with the name and
+// country the geocoder resolved it from.
+type Location struct {
+	Lat     float64
+	Lon     float64
+	Name    string
+	Country string
+}
+
+// Geocoder resolves a human-readable place into a Location. Concrete
+// implementations wrap a specific upstream geocoding API.
+type Geocoder interface {
+	GeocodeCity(ctx context.Context, name string) (*Location, error)
+	GeocodeZip(ctx context.Context, zip, country string) (*Location, error)
+}
+
+type openWeatherGeocodeCityResult struct {
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+type openWeatherGeocodeZipResult struct {
+	Name    string  `json:"name"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	Country string  `json:"country"`
+}
+
+// OpenWeatherGeocoder resolves locations using OpenWeatherMap's geocoding
+// API (https://openweathermap.org/api/geocoding-api).
+type OpenWeatherGeocoder struct {
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+func NewOpenWeatherGeocoder(apiKey string) *OpenWeatherGeocoder {
+	return &OpenWeatherGeocoder{APIKey: apiKey, HTTPClient: newUpstreamHTTPClient()}
+}
+
+func (g *OpenWeatherGeocoder) GeocodeCity(ctx context.Context, name string) (*Location, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s",
+		url.QueryEscape(name), g.APIKey,
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if err := classifyUpstreamResponse(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []openWeatherGeocodeCityResult
+	if err := json.Unmarshal(respBody, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: no location found for city %q", ErrInvalidCoordinates, name)
+	}
+
+	return &Location{
+		Lat:     results[0].Lat,
+		Lon:     results[0].Lon,
+		Name:    results[0].Name,
+		Country: results[0].Country,
+	}, nil
+}
+
+func (g *OpenWeatherGeocoder) GeocodeZip(ctx context.Context, zip, country string) (*Location, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"https://api.openweathermap.org/geo/1.0/zip?zip=%s,%s&appid=%s",
+		url.QueryEscape(zip), url.QueryEscape(country), g.APIKey,
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	// Unlike the direct (city) geocoding endpoint, which returns 200 with
+	// an empty array for no match, the zip endpoint returns 404 for an
+	// unrecognized zip/country pair. Treat that as a bad request, the
+	// same as city lookup's "no match" case, rather than classifying it
+	// as an upstream failure.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: no location found for zip %q, %q", ErrInvalidCoordinates, zip, country)
+	}
+	if err := classifyUpstreamResponse(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result openWeatherGeocodeZipResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, err
+	}
+	if result.Lat == 0 && result.Lon == 0 {
+		return nil, fmt.Errorf("%w: no location found for zip %q, %q", ErrInvalidCoordinates, zip, country)
+	}
+
+	return &Location{
+		Lat:     result.Lat,
+		Lon:     result.Lon,
+		Name:    result.Name,
+		Country: result.Country,
+	}, nil
+}
+
+// CachingGeocoder wraps a Geocoder with an in-process cache, keyed by the
+// normalized lookup (city name or zip+country). Geocoding results rarely
+// change, so entries never expire; WEATHER_CACHE_ADMIN_TOKEN's /cache/purge
+// only affects the on-disk weather cache, not this one.
+type CachingGeocoder struct {
+	Upstream Geocoder
+
+	mu    sync.RWMutex
+	cache map[string]*Location
+}
+
+func NewCachingGeocoder(upstream Geocoder) *CachingGeocoder {
+	return &CachingGeocoder{Upstream: upstream, cache: make(map[string]*Location)}
+}
+
+func (c *CachingGeocoder) GeocodeCity(ctx context.Context, name string) (*Location, error) {
+	key := "city:" + strings.ToLower(name)
+	if loc, ok := c.get(key); ok {
+		return loc, nil
+	}
+
+	loc, err := c.Upstream.GeocodeCity(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c.set(key, loc)
+	return loc, nil
+}
+
+func (c *CachingGeocoder) GeocodeZip(ctx context.Context, zip, country string) (*Location, error) {
+	key := "zip:" + strings.ToLower(zip+","+country)
+	if loc, ok := c.get(key); ok {
+		return loc, nil
+	}
+
+	loc, err := c.Upstream.GeocodeZip(ctx, zip, country)
+	if err != nil {
+		return nil, err
+	}
+	c.set(key, loc)
+	return loc, nil
+}
+
+func (c *CachingGeocoder) get(key string) (*Location, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	loc, ok := c.cache[key]
+	return loc, ok
+}
+
+func (c *CachingGeocoder) set(key string, loc *Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache[key] = loc
+}
+
+// cityContext resolves the {name} URL param to a lat/lon via geocoder and
+// stores them in the request context under the same keys latLonContext
+// uses, so weatherHandler can serve any of the coordinate/city/zip routes.
+func cityContext(geocoder Geocoder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name := chi.URLParam(r, "name")
+			loc, err := geocoder.GeocodeCity(r.Context(), name)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), URLParamKey("lat"), loc.Lat)
+			ctx = context.WithValue(ctx, URLParamKey("lon"), loc.Lon)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// zipContext resolves the {zip} and {country} URL params to a lat/lon via
+// geocoder, the same way cityContext does for a city name.
+func zipContext(geocoder Geocoder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			zip := chi.URLParam(r, "zip")
+			country := chi.URLParam(r, "country")
+			loc, err := geocoder.GeocodeZip(r.Context(), zip, country)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), URLParamKey("lat"), loc.Lat)
+			ctx = context.WithValue(ctx, URLParamKey("lon"), loc.Lon)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
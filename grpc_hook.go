@@ -0,0 +1,9 @@
+package main
+
+// grpcServerHook starts the gRPC surface defined in proto/weather.proto
+// and implemented in grpcserver.go. It is nil in a default build: that
+// file carries a `grpc` build tag so binaries that don't need the gRPC
+// surface (and the google.golang.org/grpc dependency tree that comes
+// with it) can skip it entirely. Building with `-tags grpc` registers
+// the real implementation here.
+var grpcServerHook func(addr string, provider WeatherProvider, cachingProvider *CachingProvider, geocoder Geocoder) error
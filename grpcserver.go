@@ -0,0 +1,211 @@
+//go:build grpc
+
+package main
+
+// Regenerate weatherpb from proto/weather.proto with:
+//go:generate protoc --go_out=. --go_opt=module=github.com/meustrus/weatherbanno-go --go-grpc_out=. --go-grpc_opt=module=github.com/meustrus/weatherbanno-go proto/weather.proto
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/meustrus/weatherbanno-go/weatherpb"
+)
+
+func init() {
+	grpcServerHook = serveGRPC
+}
+
+// serveGRPC starts a gRPC server on addr exposing weatherService. It
+// blocks until the listener fails, matching http.ListenAndServe's
+// contract so main can run it the same way it runs the chi router.
+func serveGRPC(addr string, provider WeatherProvider, cachingProvider *CachingProvider, geocoder Geocoder) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer()
+	weatherpb.RegisterWeatherServiceServer(grpcServer, &weatherService{
+		Provider:        provider,
+		CachingProvider: cachingProvider,
+		Geocoder:        geocoder,
+	})
+	return grpcServer.Serve(lis)
+}
+
+// weatherService implements weatherpb.WeatherServiceServer on top of the
+// same WeatherProvider/CachingProvider and Geocoder the REST handlers in
+// weatherbanno.go use, so both surfaces stay consistent by construction.
+type weatherService struct {
+	weatherpb.UnimplementedWeatherServiceServer
+
+	Provider        WeatherProvider
+	CachingProvider *CachingProvider
+	Geocoder        Geocoder
+}
+
+func (s *weatherService) resolveLocation(ctx context.Context, loc *weatherpb.Location) (lat, lon float64, err error) {
+	switch query := loc.GetQuery().(type) {
+	case *weatherpb.Location_Coord:
+		return query.Coord.GetLat(), query.Coord.GetLon(), nil
+	case *weatherpb.Location_City:
+		resolved, err := s.Geocoder.GeocodeCity(ctx, query.City.GetName())
+		if err != nil {
+			return 0, 0, err
+		}
+		return resolved.Lat, resolved.Lon, nil
+	case *weatherpb.Location_Zip:
+		resolved, err := s.Geocoder.GeocodeZip(ctx, query.Zip.GetZip(), query.Zip.GetCountry())
+		if err != nil {
+			return 0, 0, err
+		}
+		return resolved.Lat, resolved.Lon, nil
+	default:
+		return 0, 0, ErrInvalidCoordinates
+	}
+}
+
+func unitsToString(units weatherpb.Units) string {
+	switch units {
+	case weatherpb.Units_UNITS_METRIC:
+		return "metric"
+	case weatherpb.Units_UNITS_IMPERIAL:
+		return "imperial"
+	default:
+		return "standard"
+	}
+}
+
+func stringToUnits(units string) weatherpb.Units {
+	switch units {
+	case "metric":
+		return weatherpb.Units_UNITS_METRIC
+	case "imperial":
+		return weatherpb.Units_UNITS_IMPERIAL
+	default:
+		return weatherpb.Units_UNITS_UNSPECIFIED
+	}
+}
+
+func (s *weatherService) fetch(ctx context.Context, loc *weatherpb.Location, units weatherpb.Units, lang string) (*NormalizedWeather, error) {
+	lat, lon, err := s.resolveLocation(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := FetchOptions{Units: unitsToString(units), Lang: lang}
+	if s.CachingProvider != nil {
+		weather, _, err := s.CachingProvider.FetchCurrentCached(ctx, lat, lon, opts)
+		return weather, err
+	}
+	return s.Provider.FetchCurrent(ctx, lat, lon, opts)
+}
+
+func (s *weatherService) Current(ctx context.Context, req *weatherpb.CurrentRequest) (*weatherpb.CurrentConditions, error) {
+	weather, err := s.fetch(ctx, req.GetLocation(), req.GetUnits(), req.GetLang())
+	if err != nil {
+		grpcStatus, _ := statusForError(err)
+		return nil, status.Error(grpcCodeForHTTPStatus(grpcStatus), err.Error())
+	}
+
+	alerts := make([]*weatherpb.Alert, 0, len(weather.Alerts))
+	for _, alert := range weather.Alerts {
+		alerts = append(alerts, &weatherpb.Alert{
+			SenderName:  alert.SenderName,
+			Event:       alert.Event,
+			Description: alert.Description,
+		})
+	}
+
+	return &weatherpb.CurrentConditions{
+		Timestamp:              time.Unix(weather.Timestamp, 0).Format(time.RFC3339),
+		CurrentTemperatureFeel: GetCurrentTemperatureFeel(weather),
+		CurrentConditions:      GetCurrentConditions(weather),
+		Alerts:                 alerts,
+		Units:                  stringToUnits(weather.Units),
+		TempMin:                weather.TempMin,
+		TempMax:                weather.TempMax,
+		Humidity:               int32(weather.Humidity),
+		WindSpeed:              weather.WindSpeed,
+		WindDirection:          int32(weather.WindDirection),
+		Pressure:               int32(weather.Pressure),
+		Visibility:             int32(weather.Visibility),
+		Sunrise:                formatUnixTimestamp(weather.Sunrise),
+		Sunset:                 formatUnixTimestamp(weather.Sunset),
+	}, nil
+}
+
+func (s *weatherService) Alerts(ctx context.Context, req *weatherpb.AlertsRequest) (*weatherpb.AlertsResponse, error) {
+	weather, err := s.fetch(ctx, req.GetLocation(), weatherpb.Units_UNITS_UNSPECIFIED, "")
+	if err != nil {
+		grpcStatus, _ := statusForError(err)
+		return nil, status.Error(grpcCodeForHTTPStatus(grpcStatus), err.Error())
+	}
+
+	alerts := make([]*weatherpb.Alert, 0, len(weather.Alerts))
+	for _, alert := range weather.Alerts {
+		alerts = append(alerts, &weatherpb.Alert{
+			SenderName:  alert.SenderName,
+			Event:       alert.Event,
+			Description: alert.Description,
+		})
+	}
+	return &weatherpb.AlertsResponse{Alerts: alerts}, nil
+}
+
+// FiveDay reports a multi-day forecast when the configured
+// WeatherProvider implements ForecastProvider (the OpenWeatherMap One
+// Call provider does; wttr.in and the standard OpenWeatherMap current
+// endpoint don't), rather than faking a response from data it doesn't
+// have.
+func (s *weatherService) FiveDay(ctx context.Context, req *weatherpb.FiveDayRequest) (*weatherpb.FiveDayResponse, error) {
+	lat, lon, err := s.resolveLocation(ctx, req.GetLocation())
+	if err != nil {
+		grpcStatus, _ := statusForError(err)
+		return nil, status.Error(grpcCodeForHTTPStatus(grpcStatus), err.Error())
+	}
+
+	forecaster, ok := s.Provider.(ForecastProvider)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the configured WeatherProvider does not support five-day forecasts")
+	}
+
+	forecast, err := forecaster.FetchFiveDay(ctx, lat, lon, FetchOptions{Units: unitsToString(req.GetUnits()), Lang: req.GetLang()})
+	if err != nil {
+		grpcStatus, _ := statusForError(err)
+		return nil, status.Error(grpcCodeForHTTPStatus(grpcStatus), err.Error())
+	}
+
+	days := make([]*weatherpb.DailyForecast, 0, len(forecast))
+	for _, day := range forecast {
+		days = append(days, &weatherpb.DailyForecast{
+			Date:       time.Unix(day.Date, 0).Format("2006-01-02"),
+			TempMin:    day.TempMin,
+			TempMax:    day.TempMax,
+			Conditions: day.Conditions,
+		})
+	}
+	return &weatherpb.FiveDayResponse{Days: days}, nil
+}
+
+// grpcCodeForHTTPStatus reuses statusForError's HTTP status mapping so
+// the gRPC and REST surfaces agree on how each sentinel error is
+// classified, translated to the nearest gRPC status code.
+func grpcCodeForHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case 400:
+		return codes.InvalidArgument
+	case 429:
+		return codes.ResourceExhausted
+	case 502:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
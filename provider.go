@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// defaultUpstreamTimeout bounds how long a single upstream HTTP call
+// (weather or geocoding) may take, overridable via WEATHER_HTTP_TIMEOUT
+// so callers under tighter latency budgets can tune it down.
+const defaultUpstreamTimeout = 10 * time.Second
+
+// newUpstreamHTTPClient builds the *http.Client every provider and
+// geocoder uses to talk to its upstream API, so none of them fall back
+// to http.Get's unbounded default client.
+func newUpstreamHTTPClient() *http.Client {
+	timeout := defaultUpstreamTimeout
+	if raw := os.Getenv("WEATHER_HTTP_TIMEOUT"); raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil {
+			timeout = parsed
+		}
+	}
+	return &http.Client{Timeout: timeout}
+}
+
+// WeatherAlert is a provider-agnostic weather alert/advisory.
+type WeatherAlert struct {
+	SenderName  string
+	Event       string
+	Description string
+}
+
+// NormalizedWeather is the internal representation that every
+// WeatherProvider must produce, regardless of the shape of the upstream
+// API it talks to. Everything downstream of a provider (handlers,
+// GetCurrentTemperatureFeel, etc.) should operate on this type instead of
+// any provider-specific response struct.
+type NormalizedWeather struct {
+	Timestamp  int64
+	Units      string
+	FeelsLike  float32
+	TempMin    float32
+	TempMax    float32
+	Conditions []string
+	Alerts     []WeatherAlert
+
+	Humidity      int
+	WindSpeed     float32
+	WindDirection int
+	Pressure      int
+	Visibility    int
+	Sunrise       int64
+	Sunset        int64
+}
+
+// FetchOptions carries the request-scoped parameters that apply to any
+// WeatherProvider fetch: the unit system OpenWeatherMap should format
+// values in, and the language its text fields (conditions, alert
+// descriptions) should be returned in.
+type FetchOptions struct {
+	// Units is one of "standard" (Kelvin, m/s), "metric" (Celsius, m/s),
+	// or "imperial" (Fahrenheit, mph). Defaults to "standard".
+	Units string
+	// Lang is an OpenWeatherMap language code, e.g. "en" or "es".
+	Lang string
+}
+
+func (o FetchOptions) units() string {
+	if o.Units == "" {
+		return "standard"
+	}
+	return o.Units
+}
+
+// WeatherProvider fetches and normalizes current weather conditions for a
+// given coordinate. Concrete implementations wrap a specific upstream API.
+type WeatherProvider interface {
+	FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error)
+	// Name identifies the provider, e.g. for cache keys and logging.
+	Name() string
+}
+
+// DailyForecast is one day of a multi-day forecast, as returned by a
+// ForecastProvider.
+type DailyForecast struct {
+	Date       int64
+	TempMin    float32
+	TempMax    float32
+	Conditions []string
+}
+
+// ForecastProvider is implemented by WeatherProviders that can also
+// return a multi-day forecast. Not every provider can: wttr.in's free
+// endpoint and the standard OpenWeatherMap /data/2.5/weather endpoint
+// only cover current conditions, so callers should type-assert for this
+// interface rather than assuming every WeatherProvider supports it.
+type ForecastProvider interface {
+	FetchFiveDay(ctx context.Context, lat, lon float64, opts FetchOptions) ([]DailyForecast, error)
+}
+
+// FailoverProvider tries each of its providers in order, returning the
+// first successful result. This lets the caller keep serving requests if
+// one upstream is down or rate-limited.
+type FailoverProvider struct {
+	Providers []WeatherProvider
+}
+
+func (f *FailoverProvider) Name() string {
+	names := make([]string, 0, len(f.Providers))
+	for _, provider := range f.Providers {
+		names = append(names, provider.Name())
+	}
+	return strings.Join(names, "+")
+}
+
+func (f *FailoverProvider) FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error) {
+	var lastErr error
+	for _, provider := range f.Providers {
+		weather, err := provider.FetchCurrent(ctx, lat, lon, opts)
+		if err == nil {
+			return weather, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("all weather providers failed, last error: %w", lastErr)
+}
+
+// FetchFiveDay implements ForecastProvider by trying each wrapped
+// provider that also supports forecasts, the same failover behavior
+// FetchCurrent uses for current conditions.
+func (f *FailoverProvider) FetchFiveDay(ctx context.Context, lat, lon float64, opts FetchOptions) ([]DailyForecast, error) {
+	var lastErr error
+	for _, provider := range f.Providers {
+		forecaster, ok := provider.(ForecastProvider)
+		if !ok {
+			continue
+		}
+		forecast, err := forecaster.FetchFiveDay(ctx, lat, lon, opts)
+		if err == nil {
+			return forecast, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		return nil, fmt.Errorf("none of the configured weather providers support five-day forecasts")
+	}
+	return nil, fmt.Errorf("all forecast-capable weather providers failed, last error: %w", lastErr)
+}
+
+// NewProviderFromEnv builds the WeatherProvider chain described by the
+// WEATHER_PROVIDERS environment variable, a comma-separated list of
+// provider names tried in order until one succeeds. It defaults to
+// "openweather-onecall" when unset.
+func NewProviderFromEnv(apiKey string) (WeatherProvider, error) {
+	providerList := os.Getenv("WEATHER_PROVIDERS")
+	if providerList == "" {
+		providerList = "openweather-onecall"
+	}
+
+	names := strings.Split(providerList, ",")
+	providers := make([]WeatherProvider, 0, len(names))
+	for _, name := range names {
+		provider, err := newProviderByName(strings.TrimSpace(name), apiKey)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	if len(providers) == 1 {
+		return providers[0], nil
+	}
+	return &FailoverProvider{Providers: providers}, nil
+}
+
+func newProviderByName(name string, apiKey string) (WeatherProvider, error) {
+	switch name {
+	case "openweather-onecall":
+		return NewOpenWeatherOneCallProvider(apiKey), nil
+	case "openweather-current":
+		return NewOpenWeatherCurrentProvider(apiKey), nil
+	case "wttr":
+		return NewWttrInProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown weather provider %q", name)
+	}
+}
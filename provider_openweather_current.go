@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+type openWeatherCurrentResponse struct {
+	DT      int64 `json:"dt"`
+	Weather []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+	Main struct {
+		FeelsLike float32 `json:"feels_like"`
+		TempMin   float32 `json:"temp_min"`
+		TempMax   float32 `json:"temp_max"`
+		Pressure  int     `json:"pressure"`
+		Humidity  int     `json:"humidity"`
+	} `json:"main"`
+	Wind struct {
+		Speed float32 `json:"speed"`
+		Deg   int     `json:"deg"`
+	} `json:"wind"`
+	Visibility int `json:"visibility"`
+	Sys        struct {
+		Sunrise int64 `json:"sunrise"`
+		Sunset  int64 `json:"sunset"`
+	} `json:"sys"`
+}
+
+// OpenWeatherCurrentProvider fetches current conditions from the standard
+// OpenWeatherMap /data/2.5/weather endpoint. Unlike the One Call API, this
+// endpoint does not return alerts.
+type OpenWeatherCurrentProvider struct {
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+func NewOpenWeatherCurrentProvider(apiKey string) *OpenWeatherCurrentProvider {
+	return &OpenWeatherCurrentProvider{APIKey: apiKey, HTTPClient: newUpstreamHTTPClient()}
+}
+
+func (p *OpenWeatherCurrentProvider) Name() string {
+	return "openweather-current"
+}
+
+func (p *OpenWeatherCurrentProvider) FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"https://api.openweathermap.org/data/2.5/weather?lat=%f&lon=%f&units=%s&lang=%s&appid=%s",
+		lat, lon, opts.units(), url.QueryEscape(opts.Lang), p.APIKey,
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if err := classifyUpstreamResponse(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var respParsed openWeatherCurrentResponse
+	if err := json.Unmarshal(respBody, &respParsed); err != nil {
+		return nil, err
+	}
+
+	conditions := make([]string, 0, len(respParsed.Weather))
+	for _, weatherCondition := range respParsed.Weather {
+		conditions = append(conditions, weatherCondition.Main)
+	}
+
+	return &NormalizedWeather{
+		Timestamp:     respParsed.DT,
+		Units:         opts.units(),
+		FeelsLike:     respParsed.Main.FeelsLike,
+		TempMin:       respParsed.Main.TempMin,
+		TempMax:       respParsed.Main.TempMax,
+		Conditions:    conditions,
+		Alerts:        []WeatherAlert{},
+		Humidity:      respParsed.Main.Humidity,
+		WindSpeed:     respParsed.Wind.Speed,
+		WindDirection: respParsed.Wind.Deg,
+		Pressure:      respParsed.Main.Pressure,
+		Visibility:    respParsed.Visibility,
+		Sunrise:       respParsed.Sys.Sunrise,
+		Sunset:        respParsed.Sys.Sunset,
+	}, nil
+}
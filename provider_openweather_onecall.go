@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+type OpenWeatherOneCallCurrentConditions struct {
+	DT         int64   `json:"dt"`
+	Sunrise    int64   `json:"sunrise"`
+	Sunset     int64   `json:"sunset"`
+	FeelsLike  float32 `json:"feels_like"`
+	Pressure   int     `json:"pressure"`
+	Humidity   int     `json:"humidity"`
+	Visibility int     `json:"visibility"`
+	WindSpeed  float32 `json:"wind_speed"`
+	WindDeg    int     `json:"wind_deg"`
+	Weather    []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+}
+
+type OpenWeatherOneCallAlert struct {
+	SenderName  string `json:"sender_name"`
+	Event       string `json:"event"`
+	Description string `json:"description"`
+}
+
+type openWeatherOneCallDaily struct {
+	DT   int64 `json:"dt"`
+	Temp struct {
+		Min float32 `json:"min"`
+		Max float32 `json:"max"`
+	} `json:"temp"`
+	Weather []struct {
+		Main string `json:"main"`
+	} `json:"weather"`
+}
+
+type OpenWeatherOneCallResponse struct {
+	Current OpenWeatherOneCallCurrentConditions `json:"current"`
+	Daily   []openWeatherOneCallDaily           `json:"daily"`
+	Alerts  []OpenWeatherOneCallAlert           `json:"alerts"`
+}
+
+// OpenWeatherOneCallProvider fetches current conditions from the
+// OpenWeatherMap One Call API.
+type OpenWeatherOneCallProvider struct {
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+func NewOpenWeatherOneCallProvider(apiKey string) *OpenWeatherOneCallProvider {
+	return &OpenWeatherOneCallProvider{APIKey: apiKey, HTTPClient: newUpstreamHTTPClient()}
+}
+
+func (p *OpenWeatherOneCallProvider) Name() string {
+	return "openweather-onecall"
+}
+
+// fetchOneCall calls the One Call endpoint and returns the parsed
+// response. The endpoint always returns its full 8-day "daily" array
+// regardless of any cnt parameter, so callers that need fewer entries
+// (FetchFiveDay) must slice the result themselves.
+func (p *OpenWeatherOneCallProvider) fetchOneCall(ctx context.Context, lat, lon float64, opts FetchOptions) (*OpenWeatherOneCallResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"https://api.openweathermap.org/data/2.5/onecall?lat=%f&lon=%f&exclude=minutely,hourly&units=%s&lang=%s&appid=%s",
+		lat, lon, opts.units(), url.QueryEscape(opts.Lang), p.APIKey,
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if err := classifyUpstreamResponse(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var respParsed OpenWeatherOneCallResponse
+	if err := json.Unmarshal(respBody, &respParsed); err != nil {
+		return nil, err
+	}
+	return &respParsed, nil
+}
+
+func (p *OpenWeatherOneCallProvider) FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error) {
+	respParsed, err := p.fetchOneCall(ctx, lat, lon, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]string, 0, len(respParsed.Current.Weather))
+	for _, weatherCondition := range respParsed.Current.Weather {
+		conditions = append(conditions, weatherCondition.Main)
+	}
+
+	alerts := make([]WeatherAlert, 0, len(respParsed.Alerts))
+	for _, alert := range respParsed.Alerts {
+		alerts = append(alerts, WeatherAlert{
+			SenderName:  alert.SenderName,
+			Event:       alert.Event,
+			Description: alert.Description,
+		})
+	}
+
+	var tempMin, tempMax float32
+	if len(respParsed.Daily) > 0 {
+		tempMin = respParsed.Daily[0].Temp.Min
+		tempMax = respParsed.Daily[0].Temp.Max
+	}
+
+	return &NormalizedWeather{
+		Timestamp:     respParsed.Current.DT,
+		Units:         opts.units(),
+		FeelsLike:     respParsed.Current.FeelsLike,
+		TempMin:       tempMin,
+		TempMax:       tempMax,
+		Conditions:    conditions,
+		Alerts:        alerts,
+		Humidity:      respParsed.Current.Humidity,
+		WindSpeed:     respParsed.Current.WindSpeed,
+		WindDirection: respParsed.Current.WindDeg,
+		Pressure:      respParsed.Current.Pressure,
+		Visibility:    respParsed.Current.Visibility,
+		Sunrise:       respParsed.Current.Sunrise,
+		Sunset:        respParsed.Current.Sunset,
+	}, nil
+}
+
+// fiveDayForecastDays is how many entries of the One Call endpoint's
+// 8-day "daily" array FetchFiveDay returns.
+const fiveDayForecastDays = 5
+
+// FetchFiveDay implements ForecastProvider using the same One Call
+// endpoint's "daily" array, which OpenWeatherMap already returns current
+// conditions from, trimmed down to fiveDayForecastDays entries.
+func (p *OpenWeatherOneCallProvider) FetchFiveDay(ctx context.Context, lat, lon float64, opts FetchOptions) ([]DailyForecast, error) {
+	respParsed, err := p.fetchOneCall(ctx, lat, lon, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	daily := respParsed.Daily
+	if len(daily) > fiveDayForecastDays {
+		daily = daily[:fiveDayForecastDays]
+	}
+
+	forecast := make([]DailyForecast, 0, len(daily))
+	for _, day := range daily {
+		conditions := make([]string, 0, len(day.Weather))
+		for _, weatherCondition := range day.Weather {
+			conditions = append(conditions, weatherCondition.Main)
+		}
+		forecast = append(forecast, DailyForecast{
+			Date:       day.DT,
+			TempMin:    day.Temp.Min,
+			TempMax:    day.Temp.Max,
+			Conditions: conditions,
+		})
+	}
+	return forecast, nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type wttrInResponse struct {
+	CurrentCondition []struct {
+		FeelsLikeC       string `json:"FeelsLikeC"`
+		FeelsLikeF       string `json:"FeelsLikeF"`
+		Humidity         string `json:"humidity"`
+		PressureMb       string `json:"pressure"`
+		VisibilityKm     string `json:"visibility"`
+		WindspeedKmph    string `json:"windspeedKmph"`
+		WinddirDegree    string `json:"winddirDegree"`
+		LocalObsDateTime string `json:"localObsDateTime"`
+		WeatherDesc      []struct {
+			Value string `json:"value"`
+		} `json:"weatherDesc"`
+	} `json:"current_condition"`
+	Weather []struct {
+		MinTempC string `json:"mintempC"`
+		MinTempF string `json:"mintempF"`
+		MaxTempC string `json:"maxtempC"`
+		MaxTempF string `json:"maxtempF"`
+	} `json:"weather"`
+}
+
+// wttrInObsDateTimeLayout matches wttr.in's "localObsDateTime" field, e.g.
+// "2009-11-27 01:00 PM".
+const wttrInObsDateTimeLayout = "2006-01-02 03:04 PM"
+
+// WttrInProvider fetches current conditions from wttr.in's JSON output
+// (https://wttr.in/?format=j1). It requires no API key, but only reports
+// conditions as a single free-text description and does not supply
+// alerts.
+type WttrInProvider struct {
+	HTTPClient *http.Client
+}
+
+func NewWttrInProvider() *WttrInProvider {
+	return &WttrInProvider{HTTPClient: newUpstreamHTTPClient()}
+}
+
+func (p *WttrInProvider) Name() string {
+	return "wttr"
+}
+
+func (p *WttrInProvider) FetchCurrent(ctx context.Context, lat, lon float64, opts FetchOptions) (*NormalizedWeather, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(
+		"https://wttr.in/%f,%f?format=j1&lang=%s", lat, lon, url.QueryEscape(opts.Lang),
+	), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if err := classifyUpstreamResponse(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var respParsed wttrInResponse
+	if err := json.Unmarshal(respBody, &respParsed); err != nil {
+		return nil, err
+	}
+
+	if len(respParsed.CurrentCondition) == 0 {
+		return nil, fmt.Errorf("%w: wttr.in response had no current_condition entries", ErrUpstreamUnavailable)
+	}
+	current := respParsed.CurrentCondition[0]
+
+	units := opts.units()
+	feelsLike, err := wttrInTemperature(current.FeelsLikeC, current.FeelsLikeF, units)
+	if err != nil {
+		return nil, err
+	}
+
+	var tempMin, tempMax float32
+	if len(respParsed.Weather) > 0 {
+		today := respParsed.Weather[0]
+		if tempMin, err = wttrInTemperature(today.MinTempC, today.MinTempF, units); err != nil {
+			return nil, err
+		}
+		if tempMax, err = wttrInTemperature(today.MaxTempC, today.MaxTempF, units); err != nil {
+			return nil, err
+		}
+	}
+
+	humidity, _ := strconv.Atoi(current.Humidity)
+	pressure, _ := strconv.Atoi(current.PressureMb)
+	visibilityKm, _ := strconv.Atoi(current.VisibilityKm)
+	windDeg, _ := strconv.Atoi(current.WinddirDegree)
+	windKmph, _ := strconv.ParseFloat(current.WindspeedKmph, 32)
+
+	conditions := make([]string, 0, len(current.WeatherDesc))
+	for _, desc := range current.WeatherDesc {
+		conditions = append(conditions, desc.Value)
+	}
+
+	timestamp := time.Now()
+	if parsed, err := time.Parse(wttrInObsDateTimeLayout, current.LocalObsDateTime); err == nil {
+		timestamp = parsed
+	}
+
+	return &NormalizedWeather{
+		Timestamp:     timestamp.Unix(),
+		Units:         units,
+		FeelsLike:     feelsLike,
+		TempMin:       tempMin,
+		TempMax:       tempMax,
+		Conditions:    conditions,
+		Alerts:        []WeatherAlert{},
+		Humidity:      humidity,
+		WindSpeed:     windSpeedFromKmph(windKmph, units),
+		WindDirection: windDeg,
+		Pressure:      pressure,
+		Visibility:    visibilityKm * 1000, // normalize to meters, matching OpenWeatherMap
+	}, nil
+}
+
+// windSpeedFromKmph converts wttr.in's km/h wind speed to the unit
+// OpenWeatherMap would report it in for the same units value, so
+// NormalizedWeather.WindSpeed carries a consistent unit regardless of
+// which provider produced it: mph for "imperial", m/s otherwise.
+func windSpeedFromKmph(kmph float64, units string) float32 {
+	if units == "imperial" {
+		return float32(kmph / 1.60934)
+	}
+	return float32(kmph / 3.6)
+}
+
+// wttrInTemperature picks the Celsius or Fahrenheit reading matching
+// units and converts it to Kelvin when units is "standard".
+func wttrInTemperature(celsius, fahrenheit, units string) (float32, error) {
+	switch units {
+	case "imperial":
+		value, err := strconv.ParseFloat(fahrenheit, 32)
+		return float32(value), err
+	case "metric":
+		value, err := strconv.ParseFloat(celsius, 32)
+		return float32(value), err
+	default:
+		value, err := strconv.ParseFloat(celsius, 32)
+		return float32(value) + 273.15, err
+	}
+}
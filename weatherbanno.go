@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
 	"os"
 	"strconv"
@@ -17,59 +15,70 @@ import (
 
 type URLParamKey string
 
-type OpenWeatherOneCallCurrentConditions struct {
-	DT        int64   `json:"dt"`
-	FeelsLike float32 `json:"feels_like"`
-	Weather   []struct {
-		Main string `json:"main"`
-	} `json:"weather"`
-}
-
-type OpenWeatherOneCallAlert struct {
-	SenderName  string `json:"sender_name"`
-	Event       string `json:"event"`
-	Description string `json:"description"`
+type MyWeatherResponse struct {
+	Timestamp              string
+	CurrentTemperatureFeel string         `json:"current_temperature_feel"`
+	CurrentConditions      []string       `json:"current_conditions"`
+	CurrentAlerts          []WeatherAlert `json:"alerts"`
+
+	Units         string  `json:"units"`
+	TempMin       float32 `json:"temp_min"`
+	TempMax       float32 `json:"temp_max"`
+	Humidity      int     `json:"humidity"`
+	WindSpeed     float32 `json:"wind_speed"`
+	WindDirection int     `json:"wind_direction"`
+	Pressure      int     `json:"pressure"`
+	Visibility    int     `json:"visibility"`
+	Sunrise       string  `json:"sunrise,omitempty"`
+	Sunset        string  `json:"sunset,omitempty"`
 }
 
-type OpenWeatherOneCallResponse struct {
-	Current OpenWeatherOneCallCurrentConditions `json:"current"`
-	Alerts  []OpenWeatherOneCallAlert           `json:"alerts"`
+// formatUnixTimestamp formats a Unix timestamp as RFC 3339, or returns an
+// empty string for the zero value some providers (e.g. wttr.in) don't
+// populate, rather than serializing it as the 1970-01-01 epoch.
+func formatUnixTimestamp(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Format(time.RFC3339)
 }
 
-type MyWeatherResponse struct {
-	Timestamp              string
-	CurrentTemperatureFeel string   `json:"current_temperature_feel`
-	CurrentConditions      []string `json:"current_conditions"`
-	// This re-uses the type from OpenWeather, which should be
-	// immediately changed if any changes to this data structure
-	// are desired
-	CurrentAlerts []OpenWeatherOneCallAlert `json:"alerts"`
+// temperatureFeelThresholds gives the cold/moderate and moderate/hot
+// boundaries for GetCurrentTemperatureFeel in the given unit system. They
+// are the same opinionated 277K/297K boundaries re-expressed in Celsius
+// and Fahrenheit, not independently chosen values.
+func temperatureFeelThresholds(units string) (cold, hot float32) {
+	switch units {
+	case "metric":
+		return 3.85, 23.85
+	case "imperial":
+		return 38.93, 74.93
+	default:
+		return 277.0, 297.0
+	}
 }
 
 // GetCurrentTemperatureFeel makes a subjective call about what counts
 // as what temperature "feel". It uses the magic numbers 277K and 297K
 // as boundaries, which are intrinsic to this opinionated function and
 // not the program as a whole (so the magic numbers should stay here)
-func GetCurrentTemperatureFeel(respParsed *OpenWeatherOneCallResponse) string {
-	if respParsed.Current.FeelsLike < 277.0 {
+func GetCurrentTemperatureFeel(weather *NormalizedWeather) string {
+	cold, hot := temperatureFeelThresholds(weather.Units)
+	if weather.FeelsLike < cold {
 		return "cold"
-	} else if respParsed.Current.FeelsLike <= 297 {
+	} else if weather.FeelsLike <= hot {
 		return "moderate"
 	} else {
 		return "hot"
 	}
 }
 
-func GetCurrentConditions(respParsed *OpenWeatherOneCallResponse) []string {
-	results := make([]string, 0, len(respParsed.Current.Weather))
-	for _, weatherCondition := range respParsed.Current.Weather {
-		results = append(results, weatherCondition.Main)
-	}
-	return results
+func GetCurrentConditions(weather *NormalizedWeather) []string {
+	return append(make([]string, 0, len(weather.Conditions)), weather.Conditions...)
 }
 
-func GetCurrentAlerts(respParsed *OpenWeatherOneCallResponse) []OpenWeatherOneCallAlert {
-	return append(make([]OpenWeatherOneCallAlert, 0, len(respParsed.Alerts)), respParsed.Alerts...)
+func GetCurrentAlerts(weather *NormalizedWeather) []WeatherAlert {
+	return append(make([]WeatherAlert, 0, len(weather.Alerts)), weather.Alerts...)
 }
 
 func main() {
@@ -78,123 +87,169 @@ func main() {
 		os.Stderr.WriteString("Missing required environment variable OPENWEATHERMAP_API_KEY\n")
 		os.Exit(1)
 	}
+
+	provider, err := NewProviderFromEnv(apiKey)
+	if err != nil {
+		os.Stderr.WriteString(err.Error() + "\n")
+		os.Exit(1)
+	}
+
+	var cachingProvider *CachingProvider
+	if cacheLocation := os.Getenv("WEATHER_CACHE_LOCATION"); cacheLocation != "" {
+		ttl := 10 * time.Minute
+		if rawTTL := os.Getenv("WEATHER_CACHE_TTL"); rawTTL != "" {
+			ttl, err = time.ParseDuration(rawTTL)
+			if err != nil {
+				os.Stderr.WriteString("Invalid WEATHER_CACHE_TTL: " + err.Error() + "\n")
+				os.Exit(1)
+			}
+		}
+
+		cache, err := NewWeatherCache(cacheLocation, ttl)
+		if err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+		cachingProvider = NewCachingProvider(provider, cache)
+
+		stop := make(chan struct{})
+		go cache.RunEvictionLoop(ttl, stop)
+	}
+
 	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
 	r.Use(middleware.Logger)
 
-	r.Get(`/hello`,
-		func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Println(r)
-					http.Error(w, http.StatusText(500), 500)
+	if cachingProvider != nil {
+		adminToken := os.Getenv("WEATHER_CACHE_ADMIN_TOKEN")
+		r.Route("/cache", func(cacheRouter chi.Router) {
+			cacheRouter.Use(requireAdminToken(adminToken))
+
+			cacheRouter.Method(http.MethodGet, "/stats", apiHandler(func(w http.ResponseWriter, r *http.Request) error {
+				statsBytes, err := json.Marshal(cachingProvider.Cache.Stats())
+				if err != nil {
+					return err
 				}
-			}()
+				w.Write(statsBytes)
+				return nil
+			}))
 
-			w.Write([]byte("hello 1!"))
+			cacheRouter.Method(http.MethodPost, "/purge", apiHandler(func(w http.ResponseWriter, r *http.Request) error {
+				if err := cachingProvider.Cache.Purge(); err != nil {
+					return err
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return nil
+			}))
 		})
+	}
 
-	r.With(latLonContext).Get(`/helloCtx`,
-		func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Println(r)
-					http.Error(w, http.StatusText(500), 500)
-				}
-			}()
+	r.Get(`/hello`, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello 1!"))
+	})
 
-			w.Write([]byte("hello 2!"))
-		})
+	r.With(latLonContext).Get(`/helloCtx`, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello 2!"))
+	})
 
-	r.With(latLonContext).Get(`/test/lat/-40/lon/40`,
-		func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Println(r)
-					http.Error(w, http.StatusText(500), 500)
-				}
-			}()
+	r.With(latLonContext).Get(`/test/lat/-40/lon/40`, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello 3!"))
+	})
 
-			w.Write([]byte("hello 3!"))
-		})
+	r.With(latLonContext).Get(`/test2/lat/{lat:[-+]?[0-9]+}/lon/{lon:[-+]?[0-9]+}`, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello 3!"))
+	})
 
-	r.With(latLonContext).Get(`/test2/lat/{lat:[-+]?[0-9]+}/lon/{lon:[-+]?[0-9]+}`,
-		func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Println(r)
-					http.Error(w, http.StatusText(500), 500)
-				}
-			}()
+	handleWeather := weatherHandler(provider, cachingProvider)
 
-			w.Write([]byte("hello 3!"))
-		})
+	r.With(latLonContext).Method(http.MethodGet, `/weather/lat/{lat:[-+]?[0-9]+(?:\.[0-9]+)?}/lon/{lon:[-+]?[0-9]+(?:\.[0-9]+)?}`, handleWeather)
+
+	geocoder := NewCachingGeocoder(NewOpenWeatherGeocoder(apiKey))
+	r.With(cityContext(geocoder)).Method(http.MethodGet, `/weather/city/{name}`, handleWeather)
+	r.With(zipContext(geocoder)).Method(http.MethodGet, `/weather/zip/{zip:[0-9]+},{country:[A-Za-z]{2}}`, handleWeather)
+
+	if grpcAddr := os.Getenv("WEATHER_GRPC_ADDR"); grpcAddr != "" {
+		if grpcServerHook == nil {
+			os.Stderr.WriteString("WEATHER_GRPC_ADDR is set, but this binary was built without gRPC support (build with -tags grpc after running `go generate -tags grpc ./...`)\n")
+			os.Exit(1)
+		}
+		go func() {
+			if err := grpcServerHook(grpcAddr, provider, cachingProvider, geocoder); err != nil {
+				os.Stderr.WriteString(err.Error() + "\n")
+				os.Exit(1)
+			}
+		}()
+	}
 
-	r.With(latLonContext).Get(`/weather/lat/{lat:[-+]?[0-9]+(?:\.[0-9]+)?}/lon/{lon:[-+]?[0-9]+(?:\.[0-9]+)?}`,
-		func(w http.ResponseWriter, r *http.Request) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Println(r)
-					http.Error(w, http.StatusText(500), 500)
-				}
-			}()
-
-			// This begins the low-level flow of this flow; again, it
-			// should definitely be extracted somewhere, but for now
-			// we don't have enough information to know where
-			resp, err := http.Get(fmt.Sprintf(
-				"https://api.openweathermap.org/data/2.5/onecall?lat=%f&lon=%f&exclude=minutely,hourly,daily&cnt=0&appid=%s",
-				r.Context().Value(URLParamKey("lat")).(float64),
-				r.Context().Value(URLParamKey("lon")).(float64),
-				apiKey,
-			))
-			panicIfErr(err)
-
-			respBody, err := ioutil.ReadAll(resp.Body)
-			panicIfErr(err)
-
-			var respParsed OpenWeatherOneCallResponse
-			err = json.Unmarshal(respBody, &respParsed)
-			panicIfErr(err)
-
-			myRespBytes, err := json.Marshal(MyWeatherResponse{
-				Timestamp:              time.Unix(respParsed.Current.DT, 0).Format(time.RFC3339),
-				CurrentTemperatureFeel: GetCurrentTemperatureFeel(&respParsed),
-				CurrentConditions:      GetCurrentConditions(&respParsed),
-				CurrentAlerts:          GetCurrentAlerts(&respParsed),
-			})
-			panicIfErr(err)
-
-			w.Write(myRespBytes)
-		})
 	http.ListenAndServe(":8080", r)
 }
 
-func panicIfErr(err error) {
-	if err != nil {
-		panic(err)
+// weatherHandler serves current weather conditions for whatever lat/lon
+// the request context carries, regardless of which route resolved them
+// (coordinates, city name, or zip code).
+func weatherHandler(provider WeatherProvider, cachingProvider *CachingProvider) apiHandler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		lat := r.Context().Value(URLParamKey("lat")).(float64)
+		lon := r.Context().Value(URLParamKey("lon")).(float64)
+		opts := FetchOptions{
+			Units: r.URL.Query().Get("units"),
+			Lang:  r.URL.Query().Get("lang"),
+		}
+
+		var weather *NormalizedWeather
+		var err error
+		if cachingProvider != nil {
+			var status CacheStatus
+			weather, status, err = cachingProvider.FetchCurrentCached(r.Context(), lat, lon, opts)
+			w.Header().Set("X-Cache", string(status))
+		} else {
+			weather, err = provider.FetchCurrent(r.Context(), lat, lon, opts)
+		}
+		if err != nil {
+			return err
+		}
+
+		myRespBytes, err := json.Marshal(MyWeatherResponse{
+			Timestamp:              time.Unix(weather.Timestamp, 0).Format(time.RFC3339),
+			CurrentTemperatureFeel: GetCurrentTemperatureFeel(weather),
+			CurrentConditions:      GetCurrentConditions(weather),
+			CurrentAlerts:          GetCurrentAlerts(weather),
+			Units:                  weather.Units,
+			TempMin:                weather.TempMin,
+			TempMax:                weather.TempMax,
+			Humidity:               weather.Humidity,
+			WindSpeed:              weather.WindSpeed,
+			WindDirection:          weather.WindDirection,
+			Pressure:               weather.Pressure,
+			Visibility:             weather.Visibility,
+			Sunrise:                formatUnixTimestamp(weather.Sunrise),
+			Sunset:                 formatUnixTimestamp(weather.Sunset),
+		})
+		if err != nil {
+			return err
+		}
+
+		w.Write(myRespBytes)
+		return nil
 	}
 }
 
 func latLonContext(next http.Handler) http.Handler {
-	// This should probably be refactored, but the application isn't big
-	// enough yet to know the best way to organize things
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer func() {
-			if r := recover(); r != nil {
-				http.Error(w, http.StatusText(400), 400)
-			}
-		}()
-
 		lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
-		panicIfErr(err)
+		if err != nil {
+			writeError(w, r, fmt.Errorf("%w: %s", ErrInvalidCoordinates, err))
+			return
+		}
 		ctx := context.WithValue(r.Context(), URLParamKey("lat"), lat)
 
 		lon, err := strconv.ParseFloat(chi.URLParam(r, "lon"), 64)
-		panicIfErr(err)
+		if err != nil {
+			writeError(w, r, fmt.Errorf("%w: %s", ErrInvalidCoordinates, err))
+			return
+		}
 		ctx = context.WithValue(ctx, URLParamKey("lon"), lon)
 
-		log.Println(ctx)
-
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }